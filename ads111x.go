@@ -227,14 +227,20 @@ type i2cdevice interface {
 	WriteReg(reg byte, buf []byte) (err error)
 }
 
-// ADC represents an ADS1113, ADS1114, or ADS1115 analog to digital converter.
+// ADC represents an ADS1113, ADS1114, ADS1115, ADS1013, ADS1014, or ADS1015
+// analog to digital converter.
 type ADC struct {
-	i2c i2cdevice
+	i2c     i2cdevice
+	variant Variant
+
+	savedThresh *thresholdSnapshot
 }
 
-// New ADC
+// New returns an ADC for the given i2c device, assuming the full-featured
+// ADS1115. Use New1113, New1114, New1015, or New1014 instead if the part on
+// the bus is one of those.
 func New(i2c i2cdevice) *ADC {
-	return &ADC{i2c: i2c}
+	return &ADC{i2c: i2c, variant: Variant1115}
 }
 
 // Close closes the ADC connection.
@@ -283,6 +289,9 @@ func (adc *ADC) Scale() (Scale, error) {
 
 // SetScale sets the full scale range.
 func (adc *ADC) SetScale(fs Scale) error {
+	if err := adc.requirePGA(); err != nil {
+		return err
+	}
 	cfg, err := adc.Config()
 	if err != nil {
 		return err
@@ -323,6 +332,9 @@ func (adc *ADC) ComparatorMode() (ComparatorMode, error) {
 
 // SetComparatorMode sets the comparator mode to Traditional or Window.
 func (adc *ADC) SetComparatorMode(cm ComparatorMode) error {
+	if err := adc.requireComparator(); err != nil {
+		return err
+	}
 	cfg, err := adc.Config()
 	if err != nil {
 		return err
@@ -343,6 +355,9 @@ func (adc *ADC) ComparatorPolarity() (ComparatorPolarity, error) {
 
 // SetComparatorPolarity sets the comparator polarity.
 func (adc *ADC) SetComparatorPolarity(cp ComparatorPolarity) error {
+	if err := adc.requireComparator(); err != nil {
+		return err
+	}
 	cfg, err := adc.Config()
 	if err != nil {
 		return err
@@ -363,6 +378,9 @@ func (adc *ADC) ComparatorLatching() (ComparatorLatching, error) {
 
 // SetComparatorLatching sets the comparator latching.
 func (adc *ADC) SetComparatorLatching(cl ComparatorLatching) error {
+	if err := adc.requireComparator(); err != nil {
+		return err
+	}
 	cfg, err := adc.Config()
 	if err != nil {
 		return err
@@ -383,6 +401,9 @@ func (adc *ADC) ComparatorQueue() (ComparatorQueue, error) {
 
 // SetComparatorQueue sets the comparator queuing mode.
 func (adc *ADC) SetComparatorQueue(cq ComparatorQueue) error {
+	if err := adc.requireComparator(); err != nil {
+		return err
+	}
 	cfg, err := adc.Config()
 	if err != nil {
 		return err
@@ -419,8 +440,29 @@ func (adc *ADC) ReadVolts(input AIN) (float64, error) {
 	return float64(cnt) * voltsPerCnt, nil
 }
 
+// SetAIN selects the input pair a subsequent ReadAIN/ReadVolts will read
+// from. It writes the config back with the OS bit as read, so on an idle
+// device in single-shot mode the write also starts a conversion; SetAIN does
+// not wait for it. It returns an error if input isn't available on this
+// ADC's Variant.
+func (adc *ADC) SetAIN(input AIN) error {
+	if err := adc.validateAIN(input); err != nil {
+		return err
+	}
+	cfg, err := adc.Config()
+	if err != nil {
+		return err
+	}
+	cfg &= ^AIN_Mask
+	cfg |= uint16(input)
+	return adc.WriteConfig(cfg)
+}
+
 // ReadAIN reads the value from the specified input.
 func (adc *ADC) ReadAIN(input AIN) (uint16, error) {
+	if err := adc.validateAIN(input); err != nil {
+		return 0, err
+	}
 	cfg, err := adc.Config()
 	if err != nil {
 		return 0, err
@@ -428,12 +470,7 @@ func (adc *ADC) ReadAIN(input AIN) (uint16, error) {
 	// If the input isn't currently selected, select it.
 	currentInput := AIN(cfg & AIN_Mask)
 	if input != currentInput {
-		// Clear input select bits.
-		newConfig := cfg & ^AIN_Mask
-		// Set new input select bits.
-		newConfig |= uint16(input)
-		// Write new config.
-		if err := adc.WriteConfig(newConfig); err != nil {
+		if err := adc.SetAIN(input); err != nil {
 			return 0, err
 		}
 	}
@@ -0,0 +1,155 @@
+package ads111x
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Bank coordinates up to four ADS111x/ADS101x devices sharing one I2C bus,
+// one per address in Addr48..Addr4B. Each device keeps its own Scale and
+// DataRate; Bank only serializes access so that no two WriteConfig calls
+// from different devices overlap on the bus.
+type Bank struct {
+	mu   sync.Mutex
+	devs map[I2CAddress]*ADC
+}
+
+// NewBank returns an empty Bank.
+func NewBank() *Bank {
+	return &Bank{devs: make(map[I2CAddress]*ADC)}
+}
+
+// Add registers adc at addr, which must be one of Addr48..Addr4B. It
+// replaces any device already registered at that address.
+func (b *Bank) Add(addr I2CAddress, adc *ADC) error {
+	switch addr {
+	case Addr48, Addr49, Addr4A, Addr4B:
+	default:
+		return fmt.Errorf("ads111x: invalid bus address 0x%x", uint8(addr))
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, exists := b.devs[addr]; !exists && len(b.devs) >= 4 {
+		return fmt.Errorf("ads111x: bank already has the maximum of 4 devices")
+	}
+	b.devs[addr] = adc
+	return nil
+}
+
+// device returns the ADC registered at addr, or an error if none is.
+func (b *Bank) device(addr I2CAddress) (*ADC, error) {
+	adc, ok := b.devs[addr]
+	if !ok {
+		return nil, fmt.Errorf("ads111x: no device registered at address 0x%x", uint8(addr))
+	}
+	return adc, nil
+}
+
+// ReadAllVolts round-robins a ReadSingleShotVolts across the devices named
+// in inputs, serialized so no two WriteConfig calls overlap on the bus.
+func (b *Bank) ReadAllVolts(inputs map[I2CAddress]AIN) (map[I2CAddress]float64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := make(map[I2CAddress]float64, len(inputs))
+	for addr, input := range inputs {
+		adc, err := b.device(addr)
+		if err != nil {
+			return nil, err
+		}
+		v, err := adc.ReadSingleShotVolts(context.Background(), input)
+		if err != nil {
+			return nil, fmt.Errorf("ads111x: reading 0x%x: %w", uint8(addr), err)
+		}
+		out[addr] = v
+	}
+	return out, nil
+}
+
+// BankSample is one reading produced by StreamAll, tagged with the address
+// of the device it came from.
+type BankSample struct {
+	Addr I2CAddress
+	Sample
+}
+
+// streamAllErrorBackoff is how long StreamAll waits before retrying a
+// target after an I2C error, so a single flaky device doesn't turn into a
+// back-to-back retry storm that starves the rest of the bus.
+const streamAllErrorBackoff = 100 * time.Millisecond
+
+// StreamAll round-robins a ReadSingleShot across the devices named in
+// inputs, pumping a BankSample onto the returned channel for each
+// successful read, until ctx is done. Each device keeps its own configured
+// Scale.
+func (b *Bank) StreamAll(ctx context.Context, inputs map[I2CAddress]AIN, buf int) (<-chan BankSample, error) {
+	b.mu.Lock()
+	type target struct {
+		addr  I2CAddress
+		adc   *ADC
+		input AIN
+	}
+	targets := make([]target, 0, len(inputs))
+	for addr, input := range inputs {
+		adc, err := b.device(addr)
+		if err != nil {
+			b.mu.Unlock()
+			return nil, err
+		}
+		targets = append(targets, target{addr: addr, adc: adc, input: input})
+	}
+	b.mu.Unlock()
+
+	out := make(chan BankSample, buf)
+	go func() {
+		defer close(out)
+		if len(targets) == 0 {
+			<-ctx.Done()
+			return
+		}
+		for {
+			for _, tgt := range targets {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+
+				b.mu.Lock()
+				cnt, err := tgt.adc.ReadSingleShot(ctx, tgt.input)
+				var fs Scale
+				if err == nil {
+					fs, err = tgt.adc.Scale()
+				}
+				b.mu.Unlock()
+				if err != nil {
+					select {
+					case <-time.After(streamAllErrorBackoff):
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				counts := int16(cnt)
+				s := BankSample{
+					Addr: tgt.addr,
+					Sample: Sample{
+						Counts: counts,
+						Volts:  countsToVolts(counts, fs),
+						T:      time.Now(),
+					},
+				}
+				select {
+				case out <- s:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
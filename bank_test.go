@@ -0,0 +1,117 @@
+package ads111x
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBankAddAcceptsFourDevicesAndReAddWhenFull(t *testing.T) {
+	b := NewBank()
+	for _, addr := range []I2CAddress{Addr48, Addr49, Addr4A, Addr4B} {
+		if err := b.Add(addr, New(newFakeI2C(DefaultConfig))); err != nil {
+			t.Fatalf("Add(0x%x): %v", addr, err)
+		}
+	}
+
+	// Re-adding an already-registered address is fine even when full.
+	if err := b.Add(Addr48, New(newFakeI2C(DefaultConfig))); err != nil {
+		t.Errorf("re-Add(Addr48) on a full bank: %v", err)
+	}
+}
+
+func TestBankAddRejectsInvalidAddress(t *testing.T) {
+	b := NewBank()
+	if err := b.Add(I2CAddress(0x50), New(newFakeI2C(DefaultConfig))); err == nil {
+		t.Error("Add with an out-of-range address: expected error, got nil")
+	}
+}
+
+func TestBankReadAllVolts(t *testing.T) {
+	b := NewBank()
+	if err := b.Add(Addr48, New(newFakeI2C(DefaultConfig))); err != nil {
+		t.Fatalf("Add(Addr48): %v", err)
+	}
+	if err := b.Add(Addr49, New(newFakeI2C(DefaultConfig))); err != nil {
+		t.Fatalf("Add(Addr49): %v", err)
+	}
+
+	out, err := b.ReadAllVolts(map[I2CAddress]AIN{
+		Addr48: AIN_0_1,
+		Addr49: AIN_0_1,
+	})
+	if err != nil {
+		t.Fatalf("ReadAllVolts: %v", err)
+	}
+	if len(out) != 2 {
+		t.Fatalf("len(out) = %d, want 2", len(out))
+	}
+	if _, ok := out[Addr48]; !ok {
+		t.Error("ReadAllVolts result missing Addr48")
+	}
+	if _, ok := out[Addr49]; !ok {
+		t.Error("ReadAllVolts result missing Addr49")
+	}
+}
+
+func TestBankReadAllVoltsUnregisteredAddress(t *testing.T) {
+	b := NewBank()
+	if err := b.Add(Addr48, New(newFakeI2C(DefaultConfig))); err != nil {
+		t.Fatalf("Add(Addr48): %v", err)
+	}
+	if _, err := b.ReadAllVolts(map[I2CAddress]AIN{Addr49: AIN_0_1}); err == nil {
+		t.Error("ReadAllVolts on an unregistered address: expected error, got nil")
+	}
+}
+
+func TestBankStreamAllStopsOnContextCancellation(t *testing.T) {
+	b := NewBank()
+	if err := b.Add(Addr48, New(newFakeI2C(DefaultConfig))); err != nil {
+		t.Fatalf("Add(Addr48): %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	ch, err := b.StreamAll(ctx, map[I2CAddress]AIN{Addr48: AIN_0_1}, 4)
+	if err != nil {
+		t.Fatalf("StreamAll: %v", err)
+	}
+
+	got := 0
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				if got == 0 {
+					t.Error("StreamAll closed with zero samples delivered")
+				}
+				return
+			}
+			got++
+		case <-deadline:
+			t.Fatal("StreamAll did not close within the test deadline")
+		}
+	}
+}
+
+func TestBankStreamAllWithNoTargetsClosesOnContextCancellation(t *testing.T) {
+	b := NewBank()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := b.StreamAll(ctx, map[I2CAddress]AIN{}, 1)
+	if err != nil {
+		t.Fatalf("StreamAll: %v", err)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Error("StreamAll with no targets: expected a closed channel, got a sample")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("StreamAll with no targets did not close after context cancellation")
+	}
+}
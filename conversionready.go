@@ -0,0 +1,71 @@
+package ads111x
+
+// thresholdSnapshot preserves the Lo_thresh/Hi_thresh registers and the
+// comparator queue setting across an EnableConversionReady/
+// DisableConversionReady round trip.
+type thresholdSnapshot struct {
+	lo, hi uint16
+	queue  ComparatorQueue
+}
+
+// EnableConversionReady repurposes the ALERT/RDY pin as a conversion-ready
+// signal, as documented in the datasheet: it writes 0x8000 to Hi_thresh and
+// 0x0000 to Lo_thresh, and, if the comparator queue is currently Disable,
+// sets it to AfterOne so the pin is actually asserted. The prior threshold
+// register values and queue setting are snapshotted so
+// DisableConversionReady can restore them. Once enabled, start a conversion
+// with SetMode(Single)+WriteConfig (or ReadSingleShot) and wait for the
+// ALERT/RDY pin's falling edge instead of polling Status.
+func (adc *ADC) EnableConversionReady() error {
+	if err := adc.requireComparator(); err != nil {
+		return err
+	}
+
+	lo, err := adc.ReadRegUint16(LoThreshReg)
+	if err != nil {
+		return err
+	}
+	hi, err := adc.ReadRegUint16(HiThreshReg)
+	if err != nil {
+		return err
+	}
+	cq, err := adc.ComparatorQueue()
+	if err != nil {
+		return err
+	}
+	adc.savedThresh = &thresholdSnapshot{lo: lo, hi: hi, queue: cq}
+
+	if err := adc.WriteReg(HiThreshReg, uint16(0x8000)); err != nil {
+		return err
+	}
+	if err := adc.WriteReg(LoThreshReg, uint16(0x0000)); err != nil {
+		return err
+	}
+
+	if cq == Disable {
+		if err := adc.SetComparatorQueue(AfterOne); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DisableConversionReady restores the Lo_thresh/Hi_thresh registers and
+// comparator queue setting snapshotted by EnableConversionReady. It is a
+// no-op if conversion-ready mode isn't currently enabled.
+func (adc *ADC) DisableConversionReady() error {
+	if adc.savedThresh == nil {
+		return nil
+	}
+	if err := adc.WriteReg(LoThreshReg, adc.savedThresh.lo); err != nil {
+		return err
+	}
+	if err := adc.WriteReg(HiThreshReg, adc.savedThresh.hi); err != nil {
+		return err
+	}
+	if err := adc.SetComparatorQueue(adc.savedThresh.queue); err != nil {
+		return err
+	}
+	adc.savedThresh = nil
+	return nil
+}
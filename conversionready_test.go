@@ -0,0 +1,86 @@
+package ads111x
+
+import "testing"
+
+func TestConversionReadyEnableDisableRoundTrip(t *testing.T) {
+	adc := New(newFakeI2C(DefaultConfig))
+
+	if err := adc.SetLoThreshold(-0.5); err != nil {
+		t.Fatalf("SetLoThreshold: %v", err)
+	}
+	if err := adc.SetHiThreshold(0.5); err != nil {
+		t.Fatalf("SetHiThreshold: %v", err)
+	}
+	if err := adc.SetComparatorQueue(AfterTwo); err != nil {
+		t.Fatalf("SetComparatorQueue: %v", err)
+	}
+
+	wantLo, err := adc.ReadRegUint16(LoThreshReg)
+	if err != nil {
+		t.Fatalf("ReadRegUint16(LoThreshReg): %v", err)
+	}
+	wantHi, err := adc.ReadRegUint16(HiThreshReg)
+	if err != nil {
+		t.Fatalf("ReadRegUint16(HiThreshReg): %v", err)
+	}
+
+	if err := adc.EnableConversionReady(); err != nil {
+		t.Fatalf("EnableConversionReady: %v", err)
+	}
+
+	if hi, err := adc.ReadRegUint16(HiThreshReg); err != nil || hi != 0x8000 {
+		t.Errorf("HiThreshReg = 0x%04x, %v, want 0x8000, nil", hi, err)
+	}
+	if lo, err := adc.ReadRegUint16(LoThreshReg); err != nil || lo != 0x0000 {
+		t.Errorf("LoThreshReg = 0x%04x, %v, want 0x0000, nil", lo, err)
+	}
+
+	if err := adc.DisableConversionReady(); err != nil {
+		t.Fatalf("DisableConversionReady: %v", err)
+	}
+
+	if gotLo, err := adc.ReadRegUint16(LoThreshReg); err != nil || gotLo != wantLo {
+		t.Errorf("LoThreshReg after disable = 0x%04x, %v, want 0x%04x, nil", gotLo, err, wantLo)
+	}
+	if gotHi, err := adc.ReadRegUint16(HiThreshReg); err != nil || gotHi != wantHi {
+		t.Errorf("HiThreshReg after disable = 0x%04x, %v, want 0x%04x, nil", gotHi, err, wantHi)
+	}
+	if cq, err := adc.ComparatorQueue(); err != nil || cq != AfterTwo {
+		t.Errorf("ComparatorQueue after disable = %v, %v, want AfterTwo, nil", cq, err)
+	}
+}
+
+func TestConversionReadySetsAndRestoresQueueWhenDisabled(t *testing.T) {
+	adc := New(newFakeI2C(DefaultConfig))
+	if err := adc.SetComparatorQueue(Disable); err != nil {
+		t.Fatalf("SetComparatorQueue: %v", err)
+	}
+
+	if err := adc.EnableConversionReady(); err != nil {
+		t.Fatalf("EnableConversionReady: %v", err)
+	}
+	if cq, err := adc.ComparatorQueue(); err != nil || cq != AfterOne {
+		t.Errorf("ComparatorQueue while enabled = %v, %v, want AfterOne, nil", cq, err)
+	}
+
+	if err := adc.DisableConversionReady(); err != nil {
+		t.Fatalf("DisableConversionReady: %v", err)
+	}
+	if cq, err := adc.ComparatorQueue(); err != nil || cq != Disable {
+		t.Errorf("ComparatorQueue after disable = %v, %v, want Disable, nil", cq, err)
+	}
+}
+
+func TestDisableConversionReadyIsNoopWithoutEnable(t *testing.T) {
+	adc := New(newFakeI2C(DefaultConfig))
+	if err := adc.DisableConversionReady(); err != nil {
+		t.Errorf("DisableConversionReady without a prior Enable: %v", err)
+	}
+}
+
+func TestConversionReadyRequiresComparator(t *testing.T) {
+	adc := New1113(newFakeI2C(DefaultConfig))
+	if err := adc.EnableConversionReady(); err == nil {
+		t.Error("EnableConversionReady on ADS1113: expected error, got nil")
+	}
+}
@@ -0,0 +1,64 @@
+// Command conversionready demonstrates wiring the ADS111x ALERT/RDY pin to
+// a periph.io GPIO interrupt so the CPU never has to poll for a single-shot
+// conversion to finish.
+package main
+
+import (
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpioreg"
+
+	"github.com/ty2/ads111x"
+)
+
+func main() {
+	i2c := mustOpenI2C() // left to the caller's platform (periph.io, machine, etc.)
+	adc := ads111x.New1115(i2c)
+
+	if err := adc.EnableConversionReady(); err != nil {
+		log.Fatalf("enable conversion ready: %v", err)
+	}
+	defer adc.DisableConversionReady()
+
+	alert := gpioreg.ByName("GPIO17")
+	if err := alert.In(gpio.PullUp, gpio.FallingEdge); err != nil {
+		log.Fatalf("configure ALERT pin: %v", err)
+	}
+
+	for {
+		cfg, err := adc.Config()
+		if err != nil {
+			log.Fatalf("read config: %v", err)
+		}
+		cfg &= ^ads111x.AIN_Mask
+		cfg |= uint16(ads111x.AIN_0_1)
+		cfg &= ^ads111x.Mode_Mask
+		cfg |= uint16(ads111x.Single)
+		cfg |= ads111x.Status_Mask // OS: start a single conversion.
+		if err := adc.WriteConfig(cfg); err != nil {
+			log.Fatalf("start conversion: %v", err)
+		}
+
+		if !alert.WaitForEdge(time.Second) {
+			log.Fatal("timed out waiting for ALERT/RDY")
+		}
+
+		cnt, err := adc.ReadAIN(ads111x.AIN_0_1)
+		if err != nil {
+			log.Fatalf("read conversion: %v", err)
+		}
+		log.Printf("AIN0/AIN1: %d counts", cnt)
+	}
+}
+
+func mustOpenI2C() interface {
+	Close() error
+	Read([]byte) error
+	ReadReg(reg byte, buf []byte) error
+	Write(buf []byte) error
+	WriteReg(reg byte, buf []byte) error
+} {
+	panic("wire up your platform's i2c device here")
+}
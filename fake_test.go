@@ -0,0 +1,41 @@
+package ads111x
+
+import "fmt"
+
+// fakeI2C is a minimal in-memory i2cdevice used to unit test the register
+// logic in this package without a real bus.
+type fakeI2C struct {
+	regs map[byte]uint16
+}
+
+// newFakeI2C returns a fakeI2C with ConfigReg seeded to cfg and the
+// threshold registers at their power-on defaults.
+func newFakeI2C(cfg uint16) *fakeI2C {
+	return &fakeI2C{regs: map[byte]uint16{
+		ConfigReg:     cfg,
+		LoThreshReg:   0x8000,
+		HiThreshReg:   0x7FFF,
+		ConversionReg: 0,
+	}}
+}
+
+func (f *fakeI2C) Close() error { return nil }
+
+func (f *fakeI2C) Read(buf []byte) error { return nil }
+
+func (f *fakeI2C) ReadReg(reg byte, buf []byte) error {
+	v := f.regs[reg]
+	buf[0] = byte(v >> 8)
+	buf[1] = byte(v)
+	return nil
+}
+
+func (f *fakeI2C) Write(buf []byte) error { return nil }
+
+func (f *fakeI2C) WriteReg(reg byte, buf []byte) error {
+	if len(buf) != 2 {
+		return fmt.Errorf("fakeI2C: WriteReg wants 2 bytes, got %d", len(buf))
+	}
+	f.regs[reg] = uint16(buf[0])<<8 | uint16(buf[1])
+	return nil
+}
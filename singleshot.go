@@ -0,0 +1,75 @@
+package ads111x
+
+import (
+	"context"
+	"time"
+)
+
+// singleShotGuardBand pads the computed conversion time to account for
+// crystal tolerance and I2C transaction overhead.
+const singleShotGuardBand = 1.1
+
+// ReadSingleShot selects input, forces Mode=Single with the OS start bit set
+// in a single WriteConfig, then blocks until the conversion finishes before
+// returning the conversion register value. Unlike ReadAIN, which writes the
+// config and reads the conversion register back to back, this does not race
+// the conversion: it first sleeps for the time a conversion takes at the
+// currently configured DataRate (plus a guard band), then polls Status until
+// Idle, bounded by ctx.
+func (adc *ADC) ReadSingleShot(ctx context.Context, input AIN) (uint16, error) {
+	if err := adc.validateAIN(input); err != nil {
+		return 0, err
+	}
+
+	cfg, err := adc.Config()
+	if err != nil {
+		return 0, err
+	}
+	cfg &= ^AIN_Mask
+	cfg |= uint16(input)
+	cfg &= ^Mode_Mask
+	cfg |= uint16(Single)
+	cfg |= Status_Mask // OS: start a single conversion.
+	if err := adc.WriteConfig(cfg); err != nil {
+		return 0, err
+	}
+
+	dr := DataRate(cfg & DataRate_Mask)
+	convTime := time.Duration(float64(time.Second) / adc.variant.sps(dr) * singleShotGuardBand)
+	select {
+	case <-time.After(convTime):
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+
+	for {
+		status, err := adc.Status()
+		if err != nil {
+			return 0, err
+		}
+		if status == Idle {
+			break
+		}
+		select {
+		case <-time.After(time.Millisecond):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	return adc.ReadRegUint16(ConversionReg)
+}
+
+// ReadSingleShotVolts is ReadSingleShot scaled to volts at the currently
+// configured Scale.
+func (adc *ADC) ReadSingleShotVolts(ctx context.Context, input AIN) (float64, error) {
+	cnt, err := adc.ReadSingleShot(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	fs, err := adc.Scale()
+	if err != nil {
+		return 0, err
+	}
+	return float64(cnt) * (ScaleRange(fs) / Resolution), nil
+}
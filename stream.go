@@ -0,0 +1,123 @@
+package ads111x
+
+import (
+	"context"
+	"time"
+)
+
+// Sample is one reading produced by Stream.
+type Sample struct {
+	Counts int16
+	Volts  float64
+	T      time.Time
+}
+
+// StreamOptions configures Stream's pacing and backpressure behavior.
+type StreamOptions struct {
+	// Ready, if set, is called once to obtain a channel that ticks on each
+	// ALERT/RDY edge (see EnableConversionReady), and Stream reads a new
+	// sample each time it fires instead of using an internal ticker sized
+	// to the configured DataRate.
+	Ready func() <-chan time.Time
+
+	// DropOldest selects the backpressure behavior when buf samples are
+	// already queued: if true, the oldest queued sample is discarded to
+	// make room for the new one; if false, Stream blocks (respecting ctx)
+	// until the consumer makes room.
+	DropOldest bool
+}
+
+// Stream switches the device into Continuous mode at the given input and
+// the currently configured Scale/DataRate, then pumps samples on the
+// returned channel until ctx is done, at which point the channel is closed
+// and the prior config is restored. By default samples are paced by an
+// internal ticker sized to the configured DataRate; pass opts.Ready to pace
+// off an ALERT/RDY GPIO edge instead.
+//
+// Stream takes opts as an explicit fourth parameter rather than a variadic
+// or functional-option argument so that StreamOptions{Ready: ...} (and its
+// DropOldest backpressure switch) is a required, visible-at-the-call-site
+// choice rather than a hidden default.
+func (adc *ADC) Stream(ctx context.Context, input AIN, buf int, opts StreamOptions) (<-chan Sample, error) {
+	if err := adc.validateAIN(input); err != nil {
+		return nil, err
+	}
+
+	prevCfg, err := adc.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := prevCfg
+	cfg &= ^AIN_Mask
+	cfg |= uint16(input)
+	cfg &= ^Mode_Mask
+	cfg |= uint16(Continuous)
+	if err := adc.WriteConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	fsrange := ScaleRange(Scale(cfg & Scale_Mask))
+	voltsPerCnt := fsrange / Resolution
+
+	var ticker *time.Ticker
+	tick := opts.Ready
+	var tickCh <-chan time.Time
+	if tick == nil {
+		period := time.Duration(float64(time.Second) / adc.variant.sps(DataRate(cfg&DataRate_Mask)))
+		ticker = time.NewTicker(period)
+		tickCh = ticker.C
+	} else {
+		tickCh = tick()
+	}
+
+	samples := make(chan Sample, buf)
+
+	go func() {
+		defer close(samples)
+		defer func() {
+			if ticker != nil {
+				ticker.Stop()
+			}
+			adc.WriteConfig(prevCfg)
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case t, ok := <-tickCh:
+				if !ok {
+					return
+				}
+				cnt, err := adc.ReadRegUint16(ConversionReg)
+				if err != nil {
+					continue
+				}
+				counts := int16(cnt)
+				s := Sample{Counts: counts, Volts: float64(counts) * voltsPerCnt, T: t}
+				if opts.DropOldest {
+					select {
+					case samples <- s:
+					default:
+						select {
+						case <-samples:
+						default:
+						}
+						select {
+						case samples <- s:
+						default:
+						}
+					}
+				} else {
+					select {
+					case samples <- s:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return samples, nil
+}
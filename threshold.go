@@ -0,0 +1,130 @@
+package ads111x
+
+import (
+	"fmt"
+	"math"
+)
+
+// countsToVolts converts a raw signed count, as stored in the conversion,
+// Lo_thresh, or Hi_thresh registers, to volts at the given full scale range.
+func countsToVolts(counts int16, fs Scale) float64 {
+	return float64(counts) * (ScaleRange(fs) / Resolution)
+}
+
+// voltsToCounts converts v to the nearest signed count representable at the
+// given full scale range, clamping to the registers' 16-bit signed range.
+func voltsToCounts(v float64, fs Scale) int16 {
+	f := math.Round(v / (ScaleRange(fs) / Resolution))
+	switch {
+	case f > math.MaxInt16:
+		f = math.MaxInt16
+	case f < math.MinInt16:
+		f = math.MinInt16
+	}
+	return int16(f)
+}
+
+// encodeThreshold converts v to the register encoding for the currently
+// configured Scale, left-justifying it on 12-bit variants the same way the
+// device itself left-justifies conversion results.
+func (adc *ADC) encodeThreshold(v float64) (uint16, error) {
+	fs, err := adc.Scale()
+	if err != nil {
+		return 0, err
+	}
+	counts := voltsToCounts(v, fs)
+	if adc.variant.is12Bit() {
+		counts &^= 0xF
+	}
+	return uint16(counts), nil
+}
+
+// decodeThreshold converts a raw Lo_thresh/Hi_thresh register value to volts
+// using the currently configured Scale.
+func (adc *ADC) decodeThreshold(raw uint16) (float64, error) {
+	fs, err := adc.Scale()
+	if err != nil {
+		return 0, err
+	}
+	return countsToVolts(int16(raw), fs), nil
+}
+
+// SetLoThreshold programs the Lo_thresh register with v, encoded at the
+// currently configured Scale.
+func (adc *ADC) SetLoThreshold(v float64) error {
+	if err := adc.requireComparator(); err != nil {
+		return err
+	}
+	counts, err := adc.encodeThreshold(v)
+	if err != nil {
+		return err
+	}
+	return adc.WriteReg(LoThreshReg, counts)
+}
+
+// SetHiThreshold programs the Hi_thresh register with v, encoded at the
+// currently configured Scale.
+func (adc *ADC) SetHiThreshold(v float64) error {
+	if err := adc.requireComparator(); err != nil {
+		return err
+	}
+	counts, err := adc.encodeThreshold(v)
+	if err != nil {
+		return err
+	}
+	return adc.WriteReg(HiThreshReg, counts)
+}
+
+// LoThreshold reads back the Lo_thresh register, decoded to volts at the
+// currently configured Scale.
+func (adc *ADC) LoThreshold() (float64, error) {
+	if err := adc.requireComparator(); err != nil {
+		return 0, err
+	}
+	raw, err := adc.ReadRegUint16(LoThreshReg)
+	if err != nil {
+		return 0, err
+	}
+	return adc.decodeThreshold(raw)
+}
+
+// HiThreshold reads back the Hi_thresh register, decoded to volts at the
+// currently configured Scale.
+func (adc *ADC) HiThreshold() (float64, error) {
+	if err := adc.requireComparator(); err != nil {
+		return 0, err
+	}
+	raw, err := adc.ReadRegUint16(HiThreshReg)
+	if err != nil {
+		return 0, err
+	}
+	return adc.decodeThreshold(raw)
+}
+
+// ConfigureWatchdog programs the comparator subsystem in one call: it sets
+// the Lo_thresh/Hi_thresh registers from low and high (at the currently
+// configured Scale) and the comparator mode, queue, polarity, and latching
+// bits, then writes the config in a single WriteConfig. In Traditional mode
+// low must be less than high, since the comparator otherwise never clears.
+func (adc *ADC) ConfigureWatchdog(low, high float64, mode ComparatorMode, queue ComparatorQueue, polarity ComparatorPolarity, latch ComparatorLatching) error {
+	if err := adc.requireComparator(); err != nil {
+		return err
+	}
+	if mode == Traditional && low >= high {
+		return fmt.Errorf("ads111x: low threshold (%v) must be less than high threshold (%v) in Traditional mode", low, high)
+	}
+	if err := adc.SetLoThreshold(low); err != nil {
+		return err
+	}
+	if err := adc.SetHiThreshold(high); err != nil {
+		return err
+	}
+
+	cfg, err := adc.Config()
+	if err != nil {
+		return err
+	}
+	cfg &= ^(ComparatorMode_Mask | ComparatorQueue_Mask | ComparatorPolarity_Mask | ComparatorLatching_Mask)
+	cfg |= uint16(mode) | uint16(queue) | uint16(polarity) | uint16(latch)
+	return adc.WriteConfig(cfg)
+}
@@ -0,0 +1,128 @@
+package ads111x
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThresholdRoundTrip(t *testing.T) {
+	adc := New(newFakeI2C(DefaultConfig))
+
+	if err := adc.SetLoThreshold(-1.0); err != nil {
+		t.Fatalf("SetLoThreshold: %v", err)
+	}
+	if err := adc.SetHiThreshold(1.0); err != nil {
+		t.Fatalf("SetHiThreshold: %v", err)
+	}
+
+	lo, err := adc.LoThreshold()
+	if err != nil {
+		t.Fatalf("LoThreshold: %v", err)
+	}
+	if math.Abs(lo-(-1.0)) > 0.001 {
+		t.Errorf("LoThreshold = %v, want ~-1.0", lo)
+	}
+
+	hi, err := adc.HiThreshold()
+	if err != nil {
+		t.Fatalf("HiThreshold: %v", err)
+	}
+	if math.Abs(hi-1.0) > 0.001 {
+		t.Errorf("HiThreshold = %v, want ~1.0", hi)
+	}
+}
+
+func TestThresholdClampsToRegisterRange(t *testing.T) {
+	adc := New(newFakeI2C(DefaultConfig))
+
+	if err := adc.SetHiThreshold(1000.0); err != nil {
+		t.Fatalf("SetHiThreshold: %v", err)
+	}
+	raw, err := adc.ReadRegUint16(HiThreshReg)
+	if err != nil {
+		t.Fatalf("ReadRegUint16: %v", err)
+	}
+	if int16(raw) != math.MaxInt16 {
+		t.Errorf("HiThreshReg = %d, want %d", int16(raw), math.MaxInt16)
+	}
+
+	if err := adc.SetLoThreshold(-1000.0); err != nil {
+		t.Fatalf("SetLoThreshold: %v", err)
+	}
+	raw, err = adc.ReadRegUint16(LoThreshReg)
+	if err != nil {
+		t.Fatalf("ReadRegUint16: %v", err)
+	}
+	if int16(raw) != math.MinInt16 {
+		t.Errorf("LoThreshReg = %d, want %d", int16(raw), math.MinInt16)
+	}
+}
+
+func TestThresholdLeftJustifiedOn12BitVariant(t *testing.T) {
+	adc := New1015(newFakeI2C(DefaultConfig))
+
+	if err := adc.SetHiThreshold(1.0); err != nil {
+		t.Fatalf("SetHiThreshold: %v", err)
+	}
+	raw, err := adc.ReadRegUint16(HiThreshReg)
+	if err != nil {
+		t.Fatalf("ReadRegUint16: %v", err)
+	}
+	if raw&0xF != 0 {
+		t.Errorf("HiThreshReg = 0x%04x, want low nibble clear", raw)
+	}
+}
+
+func TestThresholdGatedOnVariantsWithoutComparator(t *testing.T) {
+	adc := New1113(newFakeI2C(DefaultConfig))
+
+	if err := adc.SetLoThreshold(-1.0); err == nil {
+		t.Error("SetLoThreshold on ADS1113: expected error, got nil")
+	}
+	if err := adc.SetHiThreshold(1.0); err == nil {
+		t.Error("SetHiThreshold on ADS1113: expected error, got nil")
+	}
+	if _, err := adc.LoThreshold(); err == nil {
+		t.Error("LoThreshold on ADS1113: expected error, got nil")
+	}
+	if _, err := adc.HiThreshold(); err == nil {
+		t.Error("HiThreshold on ADS1113: expected error, got nil")
+	}
+}
+
+func TestConfigureWatchdogRequiresComparator(t *testing.T) {
+	adc := New1113(newFakeI2C(DefaultConfig))
+
+	if err := adc.ConfigureWatchdog(-1.0, 1.0, Traditional, AfterOne, ActiveLow, Off); err == nil {
+		t.Error("ConfigureWatchdog on ADS1113: expected error, got nil")
+	}
+}
+
+func TestConfigureWatchdogRejectsInvertedThresholdsInTraditionalMode(t *testing.T) {
+	adc := New(newFakeI2C(DefaultConfig))
+
+	if err := adc.ConfigureWatchdog(1.0, -1.0, Traditional, AfterOne, ActiveLow, Off); err == nil {
+		t.Error("ConfigureWatchdog with low >= high in Traditional mode: expected error, got nil")
+	}
+}
+
+func TestConfigureWatchdogProgramsComparatorBits(t *testing.T) {
+	adc := New(newFakeI2C(DefaultConfig))
+
+	if err := adc.ConfigureWatchdog(-0.5, 0.5, Window, AfterTwo, ActiveHigh, On); err != nil {
+		t.Fatalf("ConfigureWatchdog: %v", err)
+	}
+
+	if cm, err := adc.ComparatorMode(); err != nil || cm != Window {
+		t.Errorf("ComparatorMode = %v, %v, want Window, nil", cm, err)
+	}
+	if cq, err := adc.ComparatorQueue(); err != nil || cq != AfterTwo {
+		t.Errorf("ComparatorQueue = %v, %v, want AfterTwo, nil", cq, err)
+	}
+	if cp, err := adc.ComparatorPolarity(); err != nil || cp != ActiveHigh {
+		t.Errorf("ComparatorPolarity = %v, %v, want ActiveHigh, nil", cp, err)
+	}
+	if cl, err := adc.ComparatorLatching(); err != nil || cl != On {
+		t.Errorf("ComparatorLatching = %v, %v, want On, nil", cl, err)
+	}
+}
@@ -0,0 +1,159 @@
+package ads111x
+
+import "fmt"
+
+// Variant identifies which part in the ADS1x1x family an ADC instance is
+// talking to. It gates which registers and helpers are safe to use, selects
+// the bit width used when encoding thresholds, and selects the data-rate
+// table used to time conversions. Raw counts are turned into volts against
+// the 16-bit Resolution on every variant, since the 12-bit parts left-justify
+// their results.
+type Variant uint8
+
+const (
+	// Variant1115 is the ADS1115: 16-bit, full 4/2-input MUX, PGA, and
+	// comparator.
+	Variant1115 Variant = iota
+	// Variant1114 is the ADS1114: 16-bit, fixed AIN0/AIN1 differential
+	// input, PGA, and comparator.
+	Variant1114
+	// Variant1113 is the ADS1113: 16-bit, fixed AIN0/AIN1 differential
+	// input, no PGA, no comparator.
+	Variant1113
+	// Variant1015 is the ADS1015: 12-bit, full 4/2-input MUX, PGA, and
+	// comparator.
+	Variant1015
+	// Variant1014 is the ADS1014: 12-bit, fixed AIN0/AIN1 differential
+	// input, PGA, and comparator.
+	Variant1014
+	// Variant1013 is the ADS1013: 12-bit, fixed AIN0/AIN1 differential
+	// input, no PGA, no comparator.
+	Variant1013
+)
+
+// String returns the part number of the variant, e.g. "ADS1115".
+func (v Variant) String() string {
+	switch v {
+	case Variant1115:
+		return "ADS1115"
+	case Variant1114:
+		return "ADS1114"
+	case Variant1113:
+		return "ADS1113"
+	case Variant1015:
+		return "ADS1015"
+	case Variant1014:
+		return "ADS1014"
+	case Variant1013:
+		return "ADS1013"
+	default:
+		return "unknown ADS1x1x variant"
+	}
+}
+
+// hasMux reports whether the part exposes the full AIN_* MUX, as opposed to
+// a fixed AIN0(pos)/AIN1(neg) differential pair.
+func (v Variant) hasMux() bool {
+	return v == Variant1115 || v == Variant1015
+}
+
+// hasPGA reports whether the part has a programmable gain amplifier, i.e.
+// SetScale has any effect.
+func (v Variant) hasPGA() bool {
+	return v != Variant1113 && v != Variant1013
+}
+
+// hasComparator reports whether the part implements the comparator/ALERT-RDY
+// subsystem, i.e. the threshold registers and comparator config bits do
+// anything.
+func (v Variant) hasComparator() bool {
+	return v.hasPGA()
+}
+
+// is12Bit reports whether conversion results only carry 12 significant bits
+// (left-justified in the 16-bit register), as opposed to the full 16.
+func (v Variant) is12Bit() bool {
+	return v == Variant1015 || v == Variant1014 || v == Variant1013
+}
+
+// dataRates16Bit is the SPS table for the ADS111x family, indexed by
+// DataRate >> DataRate_LSB.
+var dataRates16Bit = [8]float64{8, 16, 32, 64, 128, 250, 475, 860}
+
+// dataRates12Bit is the SPS table for the ADS101x family, indexed by
+// DataRate >> DataRate_LSB.
+var dataRates12Bit = [8]float64{128, 250, 490, 920, 1600, 2400, 3300, 3300}
+
+// sps returns the samples-per-second rate that dr selects on this variant.
+func (v Variant) sps(dr DataRate) float64 {
+	idx := uint16(dr) >> DataRate_LSB
+	if v.is12Bit() {
+		return dataRates12Bit[idx]
+	}
+	return dataRates16Bit[idx]
+}
+
+// New1115 returns an ADC bound to an ADS1115: 16-bit, full MUX, PGA, and
+// comparator.
+func New1115(i2c i2cdevice) *ADC {
+	return &ADC{i2c: i2c, variant: Variant1115}
+}
+
+// New1114 returns an ADC bound to an ADS1114: 16-bit, fixed AIN0/AIN1
+// differential input, PGA, and comparator.
+func New1114(i2c i2cdevice) *ADC {
+	return &ADC{i2c: i2c, variant: Variant1114}
+}
+
+// New1113 returns an ADC bound to an ADS1113: 16-bit, fixed AIN0/AIN1
+// differential input, no PGA, no comparator.
+func New1113(i2c i2cdevice) *ADC {
+	return &ADC{i2c: i2c, variant: Variant1113}
+}
+
+// New1015 returns an ADC bound to an ADS1015: 12-bit, full MUX, PGA, and
+// comparator.
+func New1015(i2c i2cdevice) *ADC {
+	return &ADC{i2c: i2c, variant: Variant1015}
+}
+
+// New1014 returns an ADC bound to an ADS1014: 12-bit, fixed AIN0/AIN1
+// differential input, PGA, and comparator.
+func New1014(i2c i2cdevice) *ADC {
+	return &ADC{i2c: i2c, variant: Variant1014}
+}
+
+// New1013 returns an ADC bound to an ADS1013: 12-bit, fixed AIN0/AIN1
+// differential input, no PGA, no comparator.
+func New1013(i2c i2cdevice) *ADC {
+	return &ADC{i2c: i2c, variant: Variant1013}
+}
+
+// Variant returns the IC variant this ADC was constructed for.
+func (adc *ADC) Variant() Variant {
+	return adc.variant
+}
+
+// validateAIN returns an error if input cannot be selected on this variant.
+func (adc *ADC) validateAIN(input AIN) error {
+	if !adc.variant.hasMux() && input != AIN_0_1 {
+		return fmt.Errorf("ads111x: %s has no input MUX; only AIN_0_1 is valid", adc.variant)
+	}
+	return nil
+}
+
+// requirePGA returns an error if this variant has no PGA.
+func (adc *ADC) requirePGA() error {
+	if !adc.variant.hasPGA() {
+		return fmt.Errorf("ads111x: %s has no PGA", adc.variant)
+	}
+	return nil
+}
+
+// requireComparator returns an error if this variant has no comparator.
+func (adc *ADC) requireComparator() error {
+	if !adc.variant.hasComparator() {
+		return fmt.Errorf("ads111x: %s has no comparator", adc.variant)
+	}
+	return nil
+}